@@ -0,0 +1,144 @@
+package go_ring_queue
+
+import (
+	"encoding/binary"
+)
+
+// Holds a queue of variable-length byte entries, stored back-to-back in a
+// `RingQueue[byte]` with each entry prefixed by a 1-5 byte uvarint length
+// header. `id` is the entry's logical write-sequence number, starting at 0
+// and incrementing by 1 for every `Push`, so `Get` can look entries up by
+// the order they were pushed in rather than their current position
+type BlobQueue struct {
+	ring       RingQueue[byte]
+	entryCount uint64
+	nextID     uint64
+}
+
+// Create a new `BlobQueue` with capacity for at least `initCapacity` bytes
+// of headers and entry data combined
+func NewBlobQueue(initCapacity uint32) BlobQueue {
+	return BlobQueue{ring: New[byte](initCapacity)}
+}
+
+// Return the current number of entries in the queue
+func (q BlobQueue) Len() int {
+	return int(q.entryCount)
+}
+
+// Append entry to the end of the queue, resizing if neccessary, and
+// return the write-sequence `id` it can later be retrieved with via `Get`
+func (q *BlobQueue) Push(entry []byte) (id uint64, err error) {
+	var header [binary.MaxVarintLen64]byte
+	hn := binary.PutUvarint(header[:], uint64(len(entry)))
+	total := hn + len(entry)
+	if err = q.ring.EnsureFreeSpace(total); err != nil {
+		return 0, err
+	}
+	frees := q.ring.GetFreeSlices()
+	copyInto2(frees, header[:hn], entry)
+	q.ring.InreaseWriteIndex(total)
+	id = q.nextID
+	q.nextID++
+	q.entryCount++
+	return id, nil
+}
+
+// Remove and return the entry at the front of the queue, and a `bool`
+// indicating whether any entry existed to return
+func (q *BlobQueue) Pop() (entry []byte, ok bool) {
+	entry, ok = q.peekFront()
+	if !ok {
+		return nil, false
+	}
+	_, hn := q.peekHeader()
+	q.ring.InreaseReadIndex(hn + len(entry))
+	q.entryCount--
+	return entry, true
+}
+
+// Return the entry at the front of the queue without removing it, and a
+// `bool` indicating whether any entry existed to return
+func (q BlobQueue) Peek() (entry []byte, ok bool) {
+	return q.peekFront()
+}
+
+// Return the entry with the given write-sequence `id`, and a `bool`
+// indicating whether it still exists in the queue. This walks the queue
+// from the front decoding one header at a time, so it is O(n) in the
+// current entry count rather than a constant-time lookup
+func (q BlobQueue) Get(id uint64) (entry []byte, ok bool) {
+	oldest := q.nextID - q.entryCount
+	if q.entryCount == 0 || id < oldest || id >= q.nextID {
+		return nil, false
+	}
+	datas := q.ring.GetDataSlices()
+	offset := 0
+	for cur := oldest; cur < q.nextID; cur++ {
+		var header [binary.MaxVarintLen64]byte
+		n := copyFromOffset(datas, offset, header[:])
+		size, hn := binary.Uvarint(header[:n])
+		if cur == id {
+			entry = make([]byte, size)
+			copyFromOffset(datas, offset+hn, entry)
+			return entry, true
+		}
+		offset += hn + int(size)
+	}
+	return nil, false
+}
+
+// Decode the uvarint length header at the front of the ring, copying it
+// into a small scratch buffer first so the decode works even if the
+// header's bytes wrap around the end of the ring
+func (q BlobQueue) peekHeader() (size uint64, hn int) {
+	datas := q.ring.GetDataSlices()
+	var header [binary.MaxVarintLen64]byte
+	n := copyFromOffset(datas, 0, header[:])
+	size, hn = binary.Uvarint(header[:n])
+	return
+}
+
+func (q BlobQueue) peekFront() (entry []byte, ok bool) {
+	if q.entryCount == 0 {
+		return nil, false
+	}
+	size, hn := q.peekHeader()
+	entry = make([]byte, size)
+	copyFromOffset(q.ring.GetDataSlices(), hn, entry)
+	return entry, true
+}
+
+// Copy len(dst) bytes (or as many as are available) starting at logical
+// offset `offset` into src[0]->src[1] (as returned by `GetDataSlices`) into
+// dst, returning the number of bytes actually copied
+func copyFromOffset(src [2][]byte, offset int, dst []byte) int {
+	total := len(src[0]) + len(src[1])
+	if avail := total - offset; avail < len(dst) {
+		dst = dst[:max(avail, 0)]
+	}
+	if offset < len(src[0]) {
+		n := copy(dst, src[0][offset:])
+		n += copy(dst[n:], src[1])
+		return n
+	}
+	return copy(dst, src[1][offset-len(src[0]):])
+}
+
+// Copy each of srcs, in order, into dst[0]->dst[1] as if they were both
+// one contiguous region, spanning the boundary between dst[0] and dst[1]
+// as neccessary
+func copyInto2(dst [2][]byte, srcs ...[]byte) {
+	di, doff := 0, 0
+	for _, src := range srcs {
+		for len(src) > 0 {
+			for doff == len(dst[di]) {
+				di++
+				doff = 0
+			}
+			n := copy(dst[di][doff:], src)
+			doff += n
+			src = src[n:]
+		}
+	}
+}
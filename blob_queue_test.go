@@ -0,0 +1,63 @@
+package go_ring_queue
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBlobQueue(t *testing.T) {
+	q := NewBlobQueue(0)
+	entries := [][]byte{
+		[]byte("hello"),
+		[]byte(""),
+		[]byte("a slightly longer entry to force a resize"),
+		{1, 2, 3, 4, 5},
+	}
+	ids := make([]uint64, len(entries))
+	for i, e := range entries {
+		id, err := q.Push(e)
+		if err != nil {
+			t.Fatalf("unexpected error pushing entry %d: %v", i, err)
+		}
+		ids[i] = id
+	}
+	if q.Len() != len(entries) {
+		t.Fatalf("expected len %d, got %d", len(entries), q.Len())
+	}
+	for i, id := range ids {
+		got, ok := q.Get(id)
+		if !ok || !slices.Equal(got, entries[i]) {
+			t.Fatalf("Get(%d): expected %v, got %v (ok=%v)", id, entries[i], got, ok)
+		}
+	}
+	peeked, ok := q.Peek()
+	if !ok || !slices.Equal(peeked, entries[0]) {
+		t.Fatalf("Peek(): expected %v, got %v (ok=%v)", entries[0], peeked, ok)
+	}
+	for i, want := range entries {
+		got, ok := q.Pop()
+		if !ok || !slices.Equal(got, want) {
+			t.Fatalf("Pop() #%d: expected %v, got %v (ok=%v)", i, want, got, ok)
+		}
+	}
+	if q.Len() != 0 {
+		t.Fatalf("expected empty queue, got len %d", q.Len())
+	}
+	if _, ok := q.Pop(); ok {
+		t.Fatalf("expected Pop() on empty queue to return ok=false")
+	}
+}
+
+func TestBlobQueueGetAfterPop(t *testing.T) {
+	q := NewBlobQueue(0)
+	id0, _ := q.Push([]byte("first"))
+	id1, _ := q.Push([]byte("second"))
+	q.Pop()
+	if _, ok := q.Get(id0); ok {
+		t.Fatalf("expected Get(%d) to fail after it was popped", id0)
+	}
+	got, ok := q.Get(id1)
+	if !ok || !slices.Equal(got, []byte("second")) {
+		t.Fatalf("Get(%d): expected %q, got %v (ok=%v)", id1, "second", got, ok)
+	}
+}
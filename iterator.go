@@ -0,0 +1,133 @@
+package go_ring_queue
+
+import "errors"
+
+// Returned from `Iterator.Err()` once `Next()`/`Prev()` detect that the
+// underlying `RingQueue[T]` was structurally mutated (via `Queue`,
+// `Dequeue`, `Clear`, `Release`, a resize triggered by `EnsureFreeSpace`,
+// or any of their variants) since the iterator was created or last reset
+var ErrInvalidated = errors.New("go_ring_queue: iterator invalidated by queue mutation")
+
+// A stateful, non-destructive iterator over a `RingQueue[T]`'s logical
+// order, obtained via `RingQueue[T].Iterator()`. An `Iterator[T]` becomes
+// invalid if the queue it was created from is structurally mutated;
+// `Next()`/`Prev()` will return `false` and `Err()` will report
+// `ErrInvalidated` from that point on, until `Begin()`/`End()` are used to
+// reset it against the queue's current state
+type Iterator[T any] struct {
+	queue *RingQueue[T]
+	gen   uint64
+	index int
+	err   error
+}
+
+// Return a new `Iterator[T]` positioned before the first element of the
+// queue
+func (q *RingQueue[T]) Iterator() Iterator[T] {
+	return Iterator[T]{queue: q, gen: q.gen, index: -1}
+}
+
+// Advance the iterator to the next element, returning whether there was
+// one to advance to
+func (it *Iterator[T]) Next() bool {
+	if !it.validate() {
+		return false
+	}
+	if it.index+1 >= it.queue.Len() {
+		it.index = it.queue.Len()
+		return false
+	}
+	it.index++
+	return true
+}
+
+// Retreat the iterator to the previous element, returning whether there
+// was one to retreat to
+func (it *Iterator[T]) Prev() bool {
+	if !it.validate() {
+		return false
+	}
+	if it.index <= 0 {
+		it.index = -1
+		return false
+	}
+	it.index--
+	return true
+}
+
+// Reset the iterator to the first element and return whether one exists.
+// Equivalent to `Begin()` followed by `Next()`
+func (it *Iterator[T]) First() bool {
+	it.Begin()
+	return it.Next()
+}
+
+// Reset the iterator to the last element and return whether one exists.
+// Equivalent to `End()` followed by `Prev()`
+func (it *Iterator[T]) Last() bool {
+	it.End()
+	return it.Prev()
+}
+
+// Reset the iterator to before the first element (as if freshly created),
+// clearing any prior invalidation
+func (it *Iterator[T]) Begin() {
+	it.index = -1
+	it.err = nil
+	it.gen = it.queue.gen
+}
+
+// Reset the iterator to after the last element, clearing any prior
+// invalidation
+func (it *Iterator[T]) End() {
+	it.index = it.queue.Len()
+	it.err = nil
+	it.gen = it.queue.gen
+}
+
+// Return the logical index of the iterator's current element
+func (it Iterator[T]) Index() int {
+	return it.index
+}
+
+// Return the value at the iterator's current element, or the zero value
+// of `T` if the iterator isn't currently positioned on one (before the
+// first element, after the last, or invalidated)
+func (it Iterator[T]) Value() T {
+	if it.queue.gen != it.gen || it.index < 0 || it.index >= it.queue.Len() {
+		var zero T
+		return zero
+	}
+	physical := (int(it.queue.ridx) + it.index) % it.queue.Cap()
+	return it.queue.RawSlice()[physical]
+}
+
+// Return `ErrInvalidated` if the queue was structurally mutated since the
+// iterator was created or last reset, otherwise `nil`
+func (it Iterator[T]) Err() error {
+	return it.err
+}
+
+func (it *Iterator[T]) validate() bool {
+	if it.queue.gen != it.gen {
+		it.err = ErrInvalidated
+		return false
+	}
+	return true
+}
+
+// Call fn once for every value currently in the queue, in logical order,
+// stopping early if fn returns false. Unlike `Iterator[T]`, this walks the
+// two slices from `GetDataSlices()` directly and performs no allocation
+func (q RingQueue[T]) Each(fn func(i int, v T) bool) {
+	datas := q.GetDataSlices()
+	i := 0
+	for _, s := range datas {
+		for _, v := range s {
+			if !fn(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package go_ring_queue
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIteratorForward(t *testing.T) {
+	q := New[int](0)
+	q.QueueMany(1, 2, 3, 4, 5)
+	// force the ring to wrap so the iterator must cross the boundary
+	q.Dequeue()
+	q.Dequeue()
+	q.Queue(6)
+	q.Queue(7)
+
+	it := q.Iterator()
+	want := []int{3, 4, 5, 6, 7}
+	got := make([]int, 0, len(want))
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if it.Err() != nil {
+		t.Fatalf("unexpected error: %v", it.Err())
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestIteratorBackwardAndFirstLast(t *testing.T) {
+	q := New[int](0)
+	q.QueueMany(1, 2, 3)
+
+	it := q.Iterator()
+	if !it.Last() || it.Value() != 3 {
+		t.Fatalf("expected Last() to land on 3")
+	}
+	if !it.Prev() || it.Value() != 2 {
+		t.Fatalf("expected Prev() to land on 2")
+	}
+	if !it.Prev() || it.Value() != 1 {
+		t.Fatalf("expected Prev() to land on 1")
+	}
+	if it.Prev() {
+		t.Fatalf("expected Prev() to fail before the first element")
+	}
+	if !it.First() || it.Value() != 1 {
+		t.Fatalf("expected First() to land on 1")
+	}
+}
+
+func TestIteratorInvalidatedByMutation(t *testing.T) {
+	q := New[int](0)
+	q.QueueMany(1, 2, 3)
+
+	it := q.Iterator()
+	it.Next()
+	q.Dequeue()
+	if it.Next() {
+		t.Fatalf("expected Next() to fail after queue mutation")
+	}
+	if !errors.Is(it.Err(), ErrInvalidated) {
+		t.Fatalf("expected ErrInvalidated, got %v", it.Err())
+	}
+	it.Begin()
+	if it.Err() != nil {
+		t.Fatalf("expected Begin() to clear the error, got %v", it.Err())
+	}
+	if !it.Next() || it.Value() != 2 {
+		t.Fatalf("expected iterator to resume at the queue's new front")
+	}
+}
+
+func TestIteratorValueOutOfRange(t *testing.T) {
+	q := New[int](0)
+	q.QueueMany(1, 2, 3)
+
+	it := q.Iterator()
+	if v := it.Value(); v != 0 {
+		t.Fatalf("expected zero value before the first Next(), got %d", v)
+	}
+	for it.Next() {
+	}
+	if v := it.Value(); v != 0 {
+		t.Fatalf("expected zero value after Next() runs past the last element, got %d", v)
+	}
+
+	var empty RingQueue[int]
+	eit := empty.Iterator()
+	if v := eit.Value(); v != 0 {
+		t.Fatalf("expected zero value on a zero-capacity queue's iterator, got %d", v)
+	}
+}
+
+func TestEach(t *testing.T) {
+	q := New[int](0)
+	q.QueueMany(1, 2, 3, 4, 5)
+
+	var got []int
+	q.Each(func(i int, v int) bool {
+		got = append(got, v)
+		return v < 3
+	})
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
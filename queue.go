@@ -1,21 +1,46 @@
 package go_ring_queue
 
 import (
+	"errors"
 	"io"
 )
 
+// Returned from `Queue`, `QueueMany`, `PushFront`, `PushFrontMany` and `Write`
+// when a bounded `RingQueue[T]` using `OverflowError` has no room left for
+// the requested values
+var ErrQueueFull = errors.New("go_ring_queue: queue is at max capacity")
+
+// Controls what a bounded `RingQueue[T]` (see `NewBounded`) does once it has
+// grown to its `maxCap` and more free space is requested
+type OverflowPolicy uint8
+
+const (
+	// Reject the request and return `ErrQueueFull`, leaving the queue unchanged
+	OverflowError OverflowPolicy = iota
+	// Silently advance the read index past the oldest queued items to make
+	// room for the new ones
+	OverflowDropOldest
+)
+
 // Holds a queue of values that are inserted at the end and removed
 // (returned) from the front. Uses a [Ring Buffer](https://en.wikipedia.org/wiki/Circular_buffer)
 // to efficiently recycle empty space without resizing the queue
 // unless absolutely neccessary
+//
+// A `RingQueue[T]` created with `New` grows without limit. One created with
+// `NewBounded` never grows past `maxCap`, and instead follows its
+// `OverflowPolicy` once full
 type RingQueue[T any] struct {
-	data []T
-	ridx uint32
-	widx uint32
+	data   []T
+	ridx   uint32
+	widx   uint32
+	maxCap uint32
+	policy OverflowPolicy
+	gen    uint64
 }
 
 // Create a new `RingQueue[T]` with capacity for at least
-// `initCapacity` items
+// `initCapacity` items, and no limit on how large it may grow
 func New[T any](initCapacity uint32) RingQueue[T] {
 	return RingQueue[T]{
 		data: make([]T, 0, initCapacity),
@@ -24,6 +49,20 @@ func New[T any](initCapacity uint32) RingQueue[T] {
 	}
 }
 
+// Create a new `RingQueue[T]` that will never grow past `maxCapacity`
+// items. Once full, `policy` determines whether further `Queue`/`QueueMany`/
+// `PushFront`/`PushFrontMany`/`Write` calls are rejected with `ErrQueueFull`
+// (`OverflowError`) or silently overwrite the oldest queued items
+// (`OverflowDropOldest`). A `maxCapacity` of 0 sets no limit, giving a
+// queue that grows like one created with `New`
+func NewBounded[T any](maxCapacity uint32, policy OverflowPolicy) RingQueue[T] {
+	return RingQueue[T]{
+		data:   make([]T, 0),
+		maxCap: maxCapacity,
+		policy: policy,
+	}
+}
+
 // Return the current length of the queue
 func (q RingQueue[T]) Len() int {
 	return len(q.data)
@@ -40,6 +79,7 @@ func (q *RingQueue[T]) Clear() {
 	q.data = q.data[:0]
 	q.ridx = 0
 	q.widx = 0
+	q.gen++
 }
 
 // Fully deinitialize the queue, releasing the memory pointer
@@ -48,6 +88,7 @@ func (q *RingQueue[T]) Release() {
 	q.data = nil
 	q.ridx = 0
 	q.widx = 0
+	q.gen++
 }
 
 // Return the base underlying slice that is holding
@@ -64,9 +105,11 @@ func (q RingQueue[T]) Clone() RingQueue[T] {
 	n := copy(newSlice, data[0])
 	copy(newSlice[n:], data[1])
 	return RingQueue[T]{
-		data: newSlice,
-		widx: uint32(q.Len()),
-		ridx: 0,
+		data:   newSlice,
+		widx:   uint32(q.Len()),
+		ridx:   0,
+		maxCap: q.maxCap,
+		policy: q.policy,
 	}
 }
 
@@ -101,6 +144,24 @@ func (q RingQueue[T]) GetFreeSlices() [2][]T {
 	return [2][]T{c1, c2}
 }
 
+// Return the free slices in-place holding the free space immediately
+// before the current front of the queue, in the order they would need
+// to be written to extend the queue backwards by `n` places.
+//
+// Returns 2 slices, such that result[0] -> result[1] is in the same
+// order that would be expected if prepending to a normal slice/list,
+// i.e. result[0][0] becomes the new front of the queue
+func (q RingQueue[T]) getFreeSlicesFront(n int) [2][]T {
+	slice := q.RawSlice()
+	newRidx := (int(q.ridx) - n + q.Cap()) % q.Cap()
+	if newRidx+n <= q.Cap() {
+		return [2][]T{slice[newRidx : newRidx+n], slice[0:0]}
+	}
+	c1 := slice[newRidx:q.Cap()]
+	c2 := slice[0 : n-len(c1)]
+	return [2][]T{c1, c2}
+}
+
 // Explicitly increase the write index of the queue by n places,
 // without writing any values
 //
@@ -109,11 +170,18 @@ func (q RingQueue[T]) GetFreeSlices() [2][]T {
 // to manually write data into the beginning of the free area,
 // or BEFORE using `GetDataSlices()` to manually write the new data to the end
 // of the extended data area
-func (q *RingQueue[T]) InreaseWriteIndex(n int) {
-	q.EnsureFreeSpace(n)
+//
+// Returns `ErrQueueFull` without modifying the queue if a bounded queue using
+// `OverflowError` does not have room for `n` more items
+func (q *RingQueue[T]) InreaseWriteIndex(n int) error {
+	if err := q.EnsureFreeSpace(n); err != nil {
+		return err
+	}
 	q.widx += uint32(n)
 	q.widx %= uint32(q.Cap())
 	q.data = q.data[:q.Len()+n]
+	q.gen++
+	return nil
 }
 
 // Explicitly increase the read index of the queue by n places,
@@ -126,48 +194,103 @@ func (q *RingQueue[T]) InreaseWriteIndex(n int) {
 // to manually read data from the beginning of the queue.
 func (q *RingQueue[T]) InreaseReadIndex(n int) (nActual int) {
 	nActual = min(q.Len(), n)
-	q.ridx += uint32(nActual)
-	q.ridx %= uint32(q.Cap())
+	if nActual > 0 {
+		q.ridx += uint32(nActual)
+		q.ridx %= uint32(q.Cap())
+		q.gen++
+	}
 	q.data = q.data[:q.Len()-nActual]
 	return
 }
 
-// Ensure the queue has space for at least n more items,
-// resizing if neccessary
-func (q *RingQueue[T]) EnsureFreeSpace(n int) {
-	if q.Cap()-q.Len() < n {
-		newSlice := append(([]T)(nil), make([]T, q.Len()+n)...)
-		oldData := q.GetDataSlices()
-		n := copy(newSlice, oldData[0])
-		copy(newSlice[n:], oldData[1])
-		q.data = newSlice
-		q.ridx = 0
-		q.widx = uint32(q.Len())
+// Ensure the queue has space for at least n more items, resizing if
+// neccessary.
+//
+// On a bounded queue (see `NewBounded`), growth never passes `maxCap`. If
+// satisfying `n` would require growing past `maxCap`, the queue's
+// `OverflowPolicy` decides what happens: `OverflowError` returns
+// `ErrQueueFull` without modifying the queue, while `OverflowDropOldest`
+// grows up to `maxCap` and then advances the read index past as many of the
+// oldest items as neccessary to free up the remaining room. Either policy
+// returns `ErrQueueFull` without modifying the queue if `n` alone exceeds
+// `maxCap`
+func (q *RingQueue[T]) EnsureFreeSpace(n int) error {
+	if q.Cap()-q.Len() >= n {
+		return nil
+	}
+	needed := q.Len() + n
+	if q.maxCap > 0 && uint32(needed) > q.maxCap {
+		if q.policy != OverflowDropOldest || n > int(q.maxCap) {
+			return ErrQueueFull
+		}
+		if q.Cap() < int(q.maxCap) {
+			q.growTo(int(q.maxCap))
+		}
+		q.InreaseReadIndex(needed - q.Cap())
+		return nil
 	}
+	q.growTo(needed)
+	return nil
+}
+
+// Resize the underlying storage to hold exactly newCap items, preserving
+// the current logical contents in order at the front of the new storage
+func (q *RingQueue[T]) growTo(newCap int) {
+	newSlice := make([]T, q.Len(), newCap)
+	oldData := q.GetDataSlices()
+	n := copy(newSlice, oldData[0])
+	copy(newSlice[n:], oldData[1])
+	q.data = newSlice
+	q.ridx = 0
+	q.widx = uint32(q.Len())
+	q.gen++
 }
 
 // Append one val to the end of the queue, resizing
 // if neccessary
-func (q *RingQueue[T]) Queue(val T) {
-	q.EnsureFreeSpace(1)
+//
+// Returns `ErrQueueFull` without modifying the queue if a bounded queue using
+// `OverflowError` has no room left for `val`
+func (q *RingQueue[T]) Queue(val T) error {
+	if err := q.EnsureFreeSpace(1); err != nil {
+		return err
+	}
 	slice := q.RawSlice()
 	slice[q.widx] = val
 	q.widx += 1
 	q.widx %= uint32(q.Cap())
 	q.data = q.data[:q.Len()+1]
+	q.gen++
+	return nil
 }
 
 // Append all vals to the end of the queue, resizing
 // if neccessary
-func (q *RingQueue[T]) QueueMany(vals ...T) {
+//
+// Returns `ErrQueueFull` without modifying the queue if a bounded queue using
+// `OverflowError` has no room left for all of `vals`. A bounded queue using
+// `OverflowDropOldest` instead keeps only the newest `maxCap` of `vals` if
+// `vals` is larger than `maxCap` on its own
+func (q *RingQueue[T]) QueueMany(vals ...T) error {
 	n := len(vals)
-	q.EnsureFreeSpace(n)
+	if n == 0 {
+		return nil
+	}
+	if q.maxCap > 0 && q.policy == OverflowDropOldest && n > int(q.maxCap) {
+		vals = vals[n-int(q.maxCap):]
+		n = len(vals)
+	}
+	if err := q.EnsureFreeSpace(n); err != nil {
+		return err
+	}
 	frees := q.GetFreeSlices()
 	nn := copy(frees[0], vals)
 	copy(frees[1], vals[nn:])
 	q.widx += uint32(n)
 	q.widx %= uint32(q.Cap())
 	q.data = q.data[:q.Len()+n]
+	q.gen++
+	return nil
 }
 
 // Remove and return the first value at the front of the queue,
@@ -183,6 +306,7 @@ func (q *RingQueue[T]) Dequeue() (val T, ok bool) {
 	q.ridx += 1
 	q.ridx %= uint32(q.Cap())
 	q.data = q.data[:q.Len()-1]
+	q.gen++
 	return
 }
 
@@ -196,8 +320,11 @@ func (q *RingQueue[T]) DequeueMany(n int) (vals []T) {
 	vals = make([]T, n)
 	nn := copy(vals, datas[0])
 	nn += copy(vals[nn:], datas[1])
-	q.ridx += uint32(nn)
-	q.ridx %= uint32(cap(q.data))
+	if nn > 0 {
+		q.ridx += uint32(nn)
+		q.ridx %= uint32(cap(q.data))
+		q.gen++
+	}
 	q.data = q.data[:q.Len()-nn]
 	vals = vals[:nn]
 	return
@@ -212,12 +339,142 @@ func (q *RingQueue[T]) DequeueManyInto(dest []T, n int) (nCopied int) {
 	datas := q.GetDataSlices()
 	nCopied = copy(dest[:n], datas[0])
 	nCopied += copy(dest[nCopied:n], datas[1])
-	q.ridx += uint32(nCopied)
-	q.ridx %= uint32(cap(q.data))
+	if nCopied > 0 {
+		q.ridx += uint32(nCopied)
+		q.ridx %= uint32(cap(q.data))
+		q.gen++
+	}
 	q.data = q.data[:q.Len()-nCopied]
 	return
 }
 
+// Prepend one val to the front of the queue, resizing
+// if neccessary
+//
+// Returns `ErrQueueFull` without modifying the queue if a bounded queue using
+// `OverflowError` has no room left for `val`
+func (q *RingQueue[T]) PushFront(val T) error {
+	if err := q.EnsureFreeSpace(1); err != nil {
+		return err
+	}
+	if q.ridx == 0 {
+		q.ridx = uint32(q.Cap()) - 1
+	} else {
+		q.ridx -= 1
+	}
+	slice := q.RawSlice()
+	slice[q.ridx] = val
+	q.data = q.data[:q.Len()+1]
+	q.gen++
+	return nil
+}
+
+// Prepend all vals to the front of the queue, resizing
+// if neccessary
+//
+// The resulting order of `vals` at the front of the queue matches
+// the order they were passed in, i.e. `vals[0]` becomes the new
+// front of the queue
+//
+// Returns `ErrQueueFull` without modifying the queue if a bounded queue using
+// `OverflowError` has no room left for all of `vals`. A bounded queue using
+// `OverflowDropOldest` instead keeps only the newest `maxCap` of `vals` if
+// `vals` is larger than `maxCap` on its own
+func (q *RingQueue[T]) PushFrontMany(vals ...T) error {
+	n := len(vals)
+	if n == 0 {
+		return nil
+	}
+	if q.maxCap > 0 && q.policy == OverflowDropOldest && n > int(q.maxCap) {
+		vals = vals[n-int(q.maxCap):]
+		n = len(vals)
+	}
+	if err := q.EnsureFreeSpace(n); err != nil {
+		return err
+	}
+	frees := q.getFreeSlicesFront(n)
+	nn := copy(frees[0], vals)
+	copy(frees[1], vals[nn:])
+	q.ridx = (q.ridx - uint32(n) + uint32(q.Cap())) % uint32(q.Cap())
+	q.data = q.data[:q.Len()+n]
+	q.gen++
+	return nil
+}
+
+// Remove and return the last value at the back of the queue,
+// and a `bool` indicating whether any value existed
+// to return
+func (q *RingQueue[T]) PopBack() (val T, ok bool) {
+	ok = q.Len() > 0
+	if !ok {
+		return
+	}
+	if q.widx == 0 {
+		q.widx = uint32(q.Cap()) - 1
+	} else {
+		q.widx -= 1
+	}
+	slice := q.RawSlice()
+	val = slice[q.widx]
+	q.data = q.data[:q.Len()-1]
+	q.gen++
+	return
+}
+
+// Remove and return up to `n` vals from the back of the queue in a new slice,
+// in logical order (i.e. `vals[len(vals)-1]` was the last item in the queue)
+//
+// If the queue has fewer than `n` items, the length of `vals`
+// will be the previous length of the queue, and the queue
+// will now be empty
+func (q *RingQueue[T]) PopBackMany(n int) (vals []T) {
+	nn := min(q.Len(), n)
+	if nn == 0 {
+		return
+	}
+	datas := q.GetDataSlices()
+	start := q.Len() - nn
+	vals = make([]T, nn)
+	if start >= len(datas[0]) {
+		copy(vals, datas[1][start-len(datas[0]):])
+	} else {
+		c := copy(vals, datas[0][start:])
+		copy(vals[c:], datas[1])
+	}
+	q.widx = (q.widx - uint32(nn) + uint32(q.Cap())) % uint32(q.Cap())
+	q.data = q.data[:q.Len()-nn]
+	q.gen++
+	return
+}
+
+// Return the value at the front of the queue without removing it,
+// and a `bool` indicating whether any value existed to return
+func (q RingQueue[T]) PeekFront() (val T, ok bool) {
+	ok = q.Len() > 0
+	if !ok {
+		return
+	}
+	val = q.RawSlice()[q.ridx]
+	return
+}
+
+// Return the value at the back of the queue without removing it,
+// and a `bool` indicating whether any value existed to return
+func (q RingQueue[T]) PeekBack() (val T, ok bool) {
+	ok = q.Len() > 0
+	if !ok {
+		return
+	}
+	idx := q.widx
+	if idx == 0 {
+		idx = uint32(q.Cap()) - 1
+	} else {
+		idx -= 1
+	}
+	val = q.RawSlice()[idx]
+	return
+}
+
 // Read is an implementation of io.Reader, genericized across all types
 //
 // Always returns error `io.EOF` if `n < len(p)`
@@ -231,10 +488,27 @@ func (q *RingQueue[T]) Read(p []T) (n int, err error) {
 
 // Write is an implementation of io.Writer, genericized across all types
 //
-// Returned error is always `nil`
+// On a bounded queue (see `NewBounded`) using `OverflowError`, `p` is
+// truncated to whatever room remains before queueing, so a short `n` is
+// always accompanied by `ErrQueueFull`, per the `io.Writer` contract for
+// partial writes. Any other queue always writes all of `p` and returns a
+// `nil` error
 func (q *RingQueue[T]) Write(p []T) (n int, err error) {
-	q.QueueMany(p...)
-	return len(p), nil
+	if q.maxCap > 0 && q.policy == OverflowError {
+		room := int(q.maxCap) - q.Len()
+		if room < 0 {
+			room = 0
+		}
+		if len(p) > room {
+			p = p[:room]
+			err = ErrQueueFull
+		}
+	}
+	if len(p) > 0 {
+		q.QueueMany(p...)
+	}
+	n = len(p)
+	return
 }
 
 // Close is an implementation of io.Closer
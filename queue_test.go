@@ -1,6 +1,7 @@
 package go_ring_queue
 
 import (
+	"errors"
 	"slices"
 	"strings"
 	"testing"
@@ -12,6 +13,10 @@ func FuzzQueue(f *testing.F) {
 		ACTION_QUEUE_MANY
 		ACTION_DEQUEUE_ONE
 		ACTION_DEQUEUE_MANY
+		ACTION_PUSH_FRONT
+		ACTION_PUSH_FRONT_MANY
+		ACTION_POP_BACK
+		ACTION_POP_BACK_MANY
 	)
 	var has2More = func(fuzzInput *[]byte) bool {
 		return len(*fuzzInput) > 1
@@ -23,7 +28,7 @@ func FuzzQueue(f *testing.F) {
 		val := (*fuzzInput)[0]
 		*fuzzInput = (*fuzzInput)[1:]
 		*i += 1
-		return val % 4
+		return val % 8
 	}
 	var getCount = func(fuzzInput *[]byte, i *int) byte {
 		val := (*fuzzInput)[0]
@@ -70,6 +75,33 @@ func FuzzQueue(f *testing.F) {
 		*list = slices.Delete(*list, 0, lnn)
 		return
 	}
+	var pushFrontOne = func(queue *RingQueue[byte], list *[]byte, val byte) {
+		queue.PushFront(val)
+		*list = slices.Insert(*list, 0, val)
+	}
+	var pushFrontMany = func(queue *RingQueue[byte], list *[]byte, vals []byte) {
+		queue.PushFrontMany(vals...)
+		*list = slices.Insert(*list, 0, vals...)
+	}
+	var popBack = func(queue *RingQueue[byte], list *[]byte) (valQ, valL byte, okQ, okL bool) {
+		valQ, okQ = queue.PopBack()
+		okL = len(*list) > 0
+		if okL {
+			valL = (*list)[len(*list)-1]
+			*list = (*list)[:len(*list)-1]
+		}
+		return
+	}
+	var popBackMany = func(queue *RingQueue[byte], list *[]byte, n byte) (valsQ, valsL []byte) {
+		qnn := min(len(queue.data), int(n))
+		valsQ = queue.PopBackMany(qnn)
+		lnn := min(len(*list), int(n))
+		start := len(*list) - lnn
+		valsL = make([]byte, lnn)
+		copy(valsL, (*list)[start:])
+		*list = (*list)[:start]
+		return
+	}
 	var sameState = func(queue RingQueue[byte], list []byte) bool {
 		if len(list) != len(queue.data) {
 			return false
@@ -143,7 +175,124 @@ func FuzzQueue(f *testing.F) {
 					t.Errorf("\ncase failed: RingQueue[byte].DequeueMany():\nEXP: %v\nGOT: %v\nCASE: % 3v\nPOS:  %s^\n", valsL, valsQ, a, strings.Repeat(" ", i*4))
 					return
 				}
+			case ACTION_PUSH_FRONT:
+				if !has1More(&aa) {
+					return
+				}
+				val := getOneVal(&aa, &i)
+				pushFrontOne(&queue, &list, val)
+				if !sameState(queue, list) {
+					qdata := queue.GetDataSlices()
+					t.Errorf("\ncase failed: RingQueue[byte].PushFront():\nEXP: %v\nGOT: %v%v\nCASE: % 3v\nPOS:  %s^\n", list, qdata[0], qdata[1], a, strings.Repeat(" ", i*4))
+					return
+				}
+			case ACTION_PUSH_FRONT_MANY:
+				if !has2More(&aa) {
+					return
+				}
+				count := getCount(&aa, &i)
+				vals := getManyVals(&aa, &i, count)
+				pushFrontMany(&queue, &list, vals)
+				if !sameState(queue, list) {
+					qdata := queue.GetDataSlices()
+					t.Errorf("\ncase failed: RingQueue[byte].PushFrontMany():\nEXP: %v\nGOT: %v%v\nCASE: % 3v\nPOS:  %s^\n", list, qdata[0], qdata[1], a, strings.Repeat(" ", i*4))
+					return
+				}
+			case ACTION_POP_BACK:
+				if len(list) == 0 {
+					continue
+				}
+				valQ, valL, okQ, okL := popBack(&queue, &list)
+				if valQ != valL || okQ != okL {
+					t.Errorf("\ncase failed: RingQueue[byte].PopBack():\nEXP: %d, %v\nGOT: %d, %v\nCASE: % 3v\nPOS:  %s^\n", valL, okL, valQ, okQ, a, strings.Repeat(" ", i*4))
+					return
+				}
+			case ACTION_POP_BACK_MANY:
+				if !has1More(&aa) {
+					return
+				}
+				count := getCount(&aa, &i)
+				count = byte(min(int(count), len(list)))
+				if count == 0 {
+					continue
+				}
+				valsQ, valsL := popBackMany(&queue, &list, count)
+				if !slices.Equal(valsQ, valsL) {
+					t.Errorf("\ncase failed: RingQueue[byte].PopBackMany():\nEXP: %v\nGOT: %v\nCASE: % 3v\nPOS:  %s^\n", valsL, valsQ, a, strings.Repeat(" ", i*4))
+					return
+				}
 			}
 		}
 	})
 }
+
+func TestBoundedOverflowError(t *testing.T) {
+	queue := NewBounded[int](3, OverflowError)
+	for _, v := range []int{1, 2, 3} {
+		if err := queue.Queue(v); err != nil {
+			t.Fatalf("unexpected error queueing %d: %v", v, err)
+		}
+	}
+	if err := queue.Queue(4); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected ErrQueueFull, got %v", err)
+	}
+	if queue.Len() != 3 {
+		t.Fatalf("expected queue to be unchanged after rejected Queue(), got len %d", queue.Len())
+	}
+	if err := queue.QueueMany(4, 5); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected ErrQueueFull, got %v", err)
+	}
+	n, err := queue.Write([]int{4, 5})
+	if n != 0 || !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected short write (0, ErrQueueFull), got (%d, %v)", n, err)
+	}
+	queue.Dequeue()
+	n, err = queue.Write([]int{4, 5})
+	if n != 1 || !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected short write (1, ErrQueueFull), got (%d, %v)", n, err)
+	}
+	datas := queue.GetDataSlices()
+	got := append(append([]int{}, datas[0]...), datas[1]...)
+	if !slices.Equal(got, []int{2, 3, 4}) {
+		t.Fatalf("unexpected contents after short write: %v", got)
+	}
+}
+
+func TestBoundedOverflowDropOldest(t *testing.T) {
+	queue := NewBounded[int](3, OverflowDropOldest)
+	queue.QueueMany(1, 2, 3)
+	if err := queue.Queue(4); err != nil {
+		t.Fatalf("unexpected error queueing 4: %v", err)
+	}
+	if queue.Len() != 3 {
+		t.Fatalf("expected length to stay capped at 3, got %d", queue.Len())
+	}
+	datas := queue.GetDataSlices()
+	got := append(append([]int{}, datas[0]...), datas[1]...)
+	if !slices.Equal(got, []int{2, 3, 4}) {
+		t.Fatalf("expected oldest item dropped, got %v", got)
+	}
+	if err := queue.QueueMany(5, 6, 7, 8); err != nil {
+		t.Fatalf("unexpected error queueing 5,6,7,8: %v", err)
+	}
+	datas = queue.GetDataSlices()
+	got = append(append([]int{}, datas[0]...), datas[1]...)
+	if !slices.Equal(got, []int{6, 7, 8}) {
+		t.Fatalf("expected queue to retain only the newest 3 items, got %v", got)
+	}
+}
+
+func TestBoundedOverflowDropOldestPushFrontMany(t *testing.T) {
+	queue := NewBounded[int](3, OverflowDropOldest)
+	if err := queue.PushFrontMany(1, 2, 3, 4, 5); err != nil {
+		t.Fatalf("unexpected error pushing front 1,2,3,4,5: %v", err)
+	}
+	if queue.Len() != 3 {
+		t.Fatalf("expected length to stay capped at 3, got %d", queue.Len())
+	}
+	datas := queue.GetDataSlices()
+	got := append(append([]int{}, datas[0]...), datas[1]...)
+	if !slices.Equal(got, []int{3, 4, 5}) {
+		t.Fatalf("expected queue to retain only the newest 3 items, got %v", got)
+	}
+}
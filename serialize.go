@@ -0,0 +1,282 @@
+package go_ring_queue
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Current version tag written by `MarshalBinary`, checked by
+// `UnmarshalBinary`
+const ringQueueBinaryVersion uint8 = 1
+
+// Returned by the binary marshaling methods, `ReadFrom` and `WriteTo`
+// when `T` does not satisfy the interface the operation needs (or, for
+// `ReadFrom`/`WriteTo`, when `T` is not `byte`)
+var ErrUnsupportedElementType = errors.New("go_ring_queue: element type does not support this operation")
+
+// Encode the queue as `version uint8, length uint32, cap uint32` followed
+// by `length` elements in logical order.
+//
+// `RingQueue[byte]` writes its bytes directly. For any other `T`, every
+// element must implement `encoding.BinaryMarshaler`, and is written as a
+// uvarint byte-length followed by its encoded bytes
+func (q RingQueue[T]) MarshalBinary() (data []byte, err error) {
+	if bq, ok := any(q).(RingQueue[byte]); ok {
+		datas := bq.GetDataSlices()
+		out := marshalBinaryHeader(bq.Len(), bq.Cap())
+		out = append(out, datas[0]...)
+		out = append(out, datas[1]...)
+		return out, nil
+	}
+	buf := bytes.NewBuffer(marshalBinaryHeader(q.Len(), q.Cap()))
+	var szBuf [binary.MaxVarintLen64]byte
+	q.Each(func(_ int, v T) bool {
+		bm, ok := any(v).(encoding.BinaryMarshaler)
+		if !ok {
+			err = ErrUnsupportedElementType
+			return false
+		}
+		var b []byte
+		b, err = bm.MarshalBinary()
+		if err != nil {
+			return false
+		}
+		n := binary.PutUvarint(szBuf[:], uint64(len(b)))
+		buf.Write(szBuf[:n])
+		buf.Write(b)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Build the `version, length, cap` header described in `MarshalBinary`
+func marshalBinaryHeader(length int, capacity int) []byte {
+	buf := make([]byte, 9, 9+length)
+	buf[0] = ringQueueBinaryVersion
+	binary.LittleEndian.PutUint32(buf[1:5], uint32(length))
+	binary.LittleEndian.PutUint32(buf[5:9], uint32(capacity))
+	return buf
+}
+
+// Return `ErrQueueFull` if decoding length elements into q would violate
+// its bounded `maxCap` (see `NewBounded`); a no-op on unbounded queues
+func (q *RingQueue[T]) checkBoundedLength(length uint32) error {
+	if q.maxCap > 0 && length > q.maxCap {
+		return ErrQueueFull
+	}
+	return nil
+}
+
+// Decode a queue previously encoded with `MarshalBinary`, replacing the
+// queue's current contents. The queue's bounded configuration (see
+// `NewBounded`), if any, is left untouched, but its `maxCap` is still
+// enforced: if `length` would exceed it, `ErrQueueFull` is returned and
+// the queue is left untouched
+func (q *RingQueue[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 9 {
+		return io.ErrUnexpectedEOF
+	}
+	if data[0] != ringQueueBinaryVersion {
+		return fmt.Errorf("go_ring_queue: unsupported MarshalBinary version %d", data[0])
+	}
+	length := binary.LittleEndian.Uint32(data[1:5])
+	capacity := binary.LittleEndian.Uint32(data[5:9])
+	if capacity < length {
+		return fmt.Errorf("go_ring_queue: malformed MarshalBinary header: cap %d smaller than length %d", capacity, length)
+	}
+	if err := q.checkBoundedLength(length); err != nil {
+		return err
+	}
+	rest := data[9:]
+	if bq, ok := any(q).(*RingQueue[byte]); ok {
+		if uint32(len(rest)) < length {
+			return io.ErrUnexpectedEOF
+		}
+		// The wire `capacity` is untrusted input and must never be handed
+		// to `make` directly (it could demand an arbitrarily large
+		// allocation); allocate only what `length` actually needs and let
+		// later growth (via `EnsureFreeSpace`) handle any legitimate need
+		// for more room
+		newData := make([]byte, length, length)
+		copy(newData, rest[:length])
+		bq.data = newData
+		bq.ridx = 0
+		bq.widx = length
+		bq.gen++
+		return nil
+	}
+	// see the byte fast path above for why `capacity` is not used here
+	newData := make([]T, 0, length)
+	for i := uint32(0); i < length; i++ {
+		size, sz := binary.Uvarint(rest)
+		if sz <= 0 {
+			return io.ErrUnexpectedEOF
+		}
+		rest = rest[sz:]
+		if uint64(len(rest)) < size {
+			return io.ErrUnexpectedEOF
+		}
+		var v T
+		bu, ok := any(&v).(encoding.BinaryUnmarshaler)
+		if !ok {
+			return ErrUnsupportedElementType
+		}
+		if err := bu.UnmarshalBinary(rest[:size]); err != nil {
+			return err
+		}
+		rest = rest[size:]
+		newData = append(newData, v)
+	}
+	q.data = newData
+	q.ridx = 0
+	q.widx = length
+	q.gen++
+	return nil
+}
+
+// Encode the queue as a JSON array of its elements, in logical order
+func (q RingQueue[T]) MarshalJSON() ([]byte, error) {
+	vals := make([]T, 0, q.Len())
+	q.Each(func(_ int, v T) bool {
+		vals = append(vals, v)
+		return true
+	})
+	return json.Marshal(vals)
+}
+
+// Decode a queue previously encoded with `MarshalJSON`, replacing the
+// queue's current contents. The queue's bounded configuration (see
+// `NewBounded`), if any, is left untouched, but its `maxCap` is still
+// enforced: if the decoded array is longer than it, `ErrQueueFull` is
+// returned and the queue is left untouched
+func (q *RingQueue[T]) UnmarshalJSON(data []byte) error {
+	var vals []T
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+	if err := q.checkBoundedLength(uint32(len(vals))); err != nil {
+		return err
+	}
+	q.data = vals
+	q.ridx = 0
+	q.widx = uint32(len(vals))
+	q.gen++
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder, encoding the queue's elements, in
+// logical order, as a gob-encoded `[]T`
+func (q RingQueue[T]) GobEncode() ([]byte, error) {
+	vals := make([]T, 0, q.Len())
+	q.Each(func(_ int, v T) bool {
+		vals = append(vals, v)
+		return true
+	})
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(vals); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, replacing the queue's current
+// contents. The queue's bounded configuration (see `NewBounded`), if any,
+// is left untouched, but its `maxCap` is still enforced: if the decoded
+// slice is longer than it, `ErrQueueFull` is returned and the queue is
+// left untouched
+func (q *RingQueue[T]) GobDecode(data []byte) error {
+	var vals []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&vals); err != nil {
+		return err
+	}
+	if err := q.checkBoundedLength(uint32(len(vals))); err != nil {
+		return err
+	}
+	q.data = vals
+	q.ridx = 0
+	q.widx = uint32(len(vals))
+	q.gen++
+	return nil
+}
+
+// ReadFrom implements io.ReaderFrom, reading from r until it returns
+// `io.EOF` (which is not reported as an error, per the interface's
+// contract) and queueing the bytes read directly into the ring's free
+// space with no intermediate buffer.
+//
+// Only meaningful on `RingQueue[byte]`; called on any other element type
+// it returns `ErrUnsupportedElementType` without reading anything
+func (q *RingQueue[T]) ReadFrom(r io.Reader) (n int64, err error) {
+	bq, ok := any(q).(*RingQueue[byte])
+	if !ok {
+		return 0, ErrUnsupportedElementType
+	}
+	for {
+		if err = bq.EnsureFreeSpace(1); err != nil {
+			return n, err
+		}
+		frees := bq.GetFreeSlices()
+		dst := frees[0]
+		if len(dst) == 0 {
+			dst = frees[1]
+		}
+		var rn int
+		rn, err = r.Read(dst)
+		if rn > 0 {
+			bq.InreaseWriteIndex(rn)
+			n += int64(rn)
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			return n, err
+		}
+	}
+}
+
+// WriteTo implements io.WriterTo, writing the queue's contents directly to
+// w with no intermediate buffer, dequeueing as it goes.
+//
+// Only meaningful on `RingQueue[byte]`; called on any other element type
+// it returns `ErrUnsupportedElementType` without writing anything
+func (q *RingQueue[T]) WriteTo(w io.Writer) (n int64, err error) {
+	bq, ok := any(q).(*RingQueue[byte])
+	if !ok {
+		return 0, ErrUnsupportedElementType
+	}
+	for bq.Len() > 0 {
+		src := bq.GetDataSlices()[0]
+		var wn int
+		wn, err = w.Write(src)
+		if wn > 0 {
+			bq.InreaseReadIndex(wn)
+			n += int64(wn)
+		}
+		if err != nil {
+			return n, err
+		}
+		if wn < len(src) {
+			return n, io.ErrShortWrite
+		}
+	}
+	return n, nil
+}
+
+var _ encoding.BinaryMarshaler = (*RingQueue[byte])(nil)
+var _ encoding.BinaryUnmarshaler = (*RingQueue[byte])(nil)
+var _ json.Marshaler = (*RingQueue[byte])(nil)
+var _ json.Unmarshaler = (*RingQueue[byte])(nil)
+var _ gob.GobEncoder = (*RingQueue[byte])(nil)
+var _ gob.GobDecoder = (*RingQueue[byte])(nil)
+var _ io.ReaderFrom = (*RingQueue[byte])(nil)
+var _ io.WriterTo = (*RingQueue[byte])(nil)
@@ -0,0 +1,198 @@
+package go_ring_queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestRingQueueBinaryRoundTripBytes(t *testing.T) {
+	q := New[byte](0)
+	q.QueueMany(1, 2, 3, 4, 5)
+	q.Dequeue()
+	q.Dequeue()
+	q.Queue(6)
+	q.Queue(7)
+
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	var out RingQueue[byte]
+	if err := out.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	got := out.GetDataSlices()
+	flat := append(append([]byte{}, got[0]...), got[1]...)
+	if !slices.Equal(flat, []byte{3, 4, 5, 6, 7}) {
+		t.Fatalf("expected [3 4 5 6 7], got %v", flat)
+	}
+}
+
+func TestRingQueueJSONRoundTripBytes(t *testing.T) {
+	q := New[byte](0)
+	q.QueueMany(9, 8, 7)
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	var out RingQueue[byte]
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	got := out.GetDataSlices()
+	flat := append(append([]byte{}, got[0]...), got[1]...)
+	if !slices.Equal(flat, []byte{9, 8, 7}) {
+		t.Fatalf("expected [9 8 7], got %v", flat)
+	}
+}
+
+func TestRingQueueGobRoundTripBytes(t *testing.T) {
+	q := New[byte](0)
+	q.QueueMany(1, 2, 3)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(q); err != nil {
+		t.Fatalf("gob encode: %v", err)
+	}
+	var out RingQueue[byte]
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("gob decode: %v", err)
+	}
+	got := out.GetDataSlices()
+	flat := append(append([]byte{}, got[0]...), got[1]...)
+	if !slices.Equal(flat, []byte{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", flat)
+	}
+}
+
+func TestRingQueueReadFromWriteTo(t *testing.T) {
+	q := New[byte](0)
+	q.QueueMany([]byte("hello, ring queue")...)
+
+	var buf bytes.Buffer
+	n, err := q.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	if n != int64(buf.Len()) || buf.String() != "hello, ring queue" {
+		t.Fatalf("unexpected WriteTo result: n=%d buf=%q", n, buf.String())
+	}
+	if q.Len() != 0 {
+		t.Fatalf("expected WriteTo to drain the queue, got len %d", q.Len())
+	}
+
+	var in RingQueue[byte]
+	n, err = in.ReadFrom(bytes.NewReader([]byte("round trip")))
+	if err != nil {
+		t.Fatalf("ReadFrom: %v", err)
+	}
+	if n != int64(len("round trip")) {
+		t.Fatalf("expected n=%d, got %d", len("round trip"), n)
+	}
+	datas := in.GetDataSlices()
+	flat := append(append([]byte{}, datas[0]...), datas[1]...)
+	if string(flat) != "round trip" {
+		t.Fatalf("expected %q, got %q", "round trip", flat)
+	}
+}
+
+func TestUnmarshalBinaryRejectsMalformedHeader(t *testing.T) {
+	// length=5, cap=2: a corrupted/hand-crafted header where cap < length
+	data := []byte{1, 5, 0, 0, 0, 2, 0, 0, 0, 'a', 'b', 'c', 'd', 'e'}
+	var q RingQueue[byte]
+	if err := q.UnmarshalBinary(data); err == nil {
+		t.Fatalf("expected an error for cap < length, got nil")
+	}
+}
+
+func TestUnmarshalBinaryIgnoresOversizedCapHeader(t *testing.T) {
+	// length=0, cap=0xFFFFFFF0: a crafted header claiming an ~4GB capacity
+	// with no backing data; the decoded queue must not allocate anywhere
+	// near that much
+	data := []byte{1, 0, 0, 0, 0, 0xF0, 0xFF, 0xFF, 0xFF}
+	var q RingQueue[byte]
+	if err := q.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if q.Cap() > 64 {
+		t.Fatalf("expected the oversized wire capacity to be ignored, got cap %d", q.Cap())
+	}
+}
+
+func TestUnmarshalBinaryRejectsOverMaxCap(t *testing.T) {
+	q := NewBounded[byte](3, OverflowError)
+	src := New[byte](0)
+	src.QueueMany([]byte("0123456789")...)
+	payload, err := src.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	if err := q.UnmarshalBinary(payload); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected ErrQueueFull, got %v", err)
+	}
+	if q.Len() != 0 {
+		t.Fatalf("expected queue left untouched, got len %d", q.Len())
+	}
+}
+
+func TestUnmarshalJSONRejectsOverMaxCap(t *testing.T) {
+	q := NewBounded[int](2, OverflowError)
+	if err := json.Unmarshal([]byte("[1,2,3]"), &q); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected ErrQueueFull, got %v", err)
+	}
+	if q.Len() != 0 {
+		t.Fatalf("expected queue left untouched, got len %d", q.Len())
+	}
+}
+
+func TestGobDecodeRejectsOverMaxCap(t *testing.T) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode([]int{1, 2, 3}); err != nil {
+		t.Fatalf("gob encode: %v", err)
+	}
+	q := NewBounded[int](2, OverflowError)
+	if err := q.GobDecode(buf.Bytes()); !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected ErrQueueFull, got %v", err)
+	}
+	if q.Len() != 0 {
+		t.Fatalf("expected queue left untouched, got len %d", q.Len())
+	}
+}
+
+// binaryInt is a minimal encoding.BinaryMarshaler/Unmarshaler used to
+// exercise the generic (non-byte) RingQueue[T] binary codec path
+type binaryInt int
+
+func (v binaryInt) MarshalBinary() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d", v)), nil
+}
+
+func (v *binaryInt) UnmarshalBinary(data []byte) error {
+	_, err := fmt.Sscanf(string(data), "%d", v)
+	return err
+}
+
+func TestRingQueueBinaryRoundTripGeneric(t *testing.T) {
+	q := New[binaryInt](0)
+	q.QueueMany(1, 22, 333)
+
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	var out RingQueue[binaryInt]
+	if err := out.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	got := out.GetDataSlices()
+	flat := append(append([]binaryInt{}, got[0]...), got[1]...)
+	if !slices.Equal(flat, []binaryInt{1, 22, 333}) {
+		t.Fatalf("expected [1 22 333], got %v", flat)
+	}
+}
@@ -0,0 +1,118 @@
+package go_ring_queue
+
+import "errors"
+
+// Returned by `DequeueFunc` when the queue has no data for the callback to
+// act on
+var ErrQueueEmpty = errors.New("go_ring_queue: queue is empty")
+
+// Reserve space for exactly one value at the end of the queue, resizing
+// if neccessary, and hand the caller a pointer directly into the
+// underlying storage via fn.
+//
+// If fn returns a non-nil error, the write index is left unchanged and
+// that error is returned; the value fn wrote (if any) is discarded.
+// Otherwise the write index is advanced by one
+//
+// Returns `ErrQueueFull` without modifying the queue if a bounded queue using
+// `OverflowError` has no room left for the value
+func (q *RingQueue[T]) QueueFunc(fn func(v *T) error) error {
+	if err := q.EnsureFreeSpace(1); err != nil {
+		return err
+	}
+	slice := q.RawSlice()
+	if err := fn(&slice[q.widx]); err != nil {
+		return err
+	}
+	q.widx += 1
+	q.widx %= uint32(q.Cap())
+	q.data = q.data[:q.Len()+1]
+	q.gen++
+	return nil
+}
+
+// Reserve space for up to n values at the end of the queue, resizing if
+// neccessary, and hand the caller the (up to 2) contiguous free regions
+// directly via fn, in the same order as `GetFreeSlices()` but truncated
+// to a combined length of n.
+//
+// fn returns the number of values it actually wrote, which must be
+// between 0 and the length of what it was given, filled from the start of
+// slice1 then slice2; that many values are then appended to
+// the queue by advancing the write index. If fn returns an error or 0,
+// the write index is left unchanged
+//
+// Returns `ErrQueueFull` without modifying the queue if a bounded queue using
+// `OverflowError` has no room left for n values
+func (q *RingQueue[T]) QueueManyFunc(n int, fn func(slice1, slice2 []T) (nWritten int, err error)) error {
+	if err := q.EnsureFreeSpace(n); err != nil {
+		return err
+	}
+	limited := limitSlicePair(q.GetFreeSlices(), n)
+	nWritten, err := fn(limited[0], limited[1])
+	if err != nil || nWritten == 0 {
+		return err
+	}
+	q.widx += uint32(nWritten)
+	q.widx %= uint32(q.Cap())
+	q.data = q.data[:q.Len()+nWritten]
+	q.gen++
+	return nil
+}
+
+// Hand the caller a pointer directly to the value at the front of the
+// queue via fn, without removing it first.
+//
+// If fn returns a non-nil error, the read index is left unchanged and
+// that error is returned. Otherwise the read index is advanced by one,
+// removing the value from the queue
+//
+// Returns `ErrQueueEmpty` without calling fn if the queue has no values
+func (q *RingQueue[T]) DequeueFunc(fn func(v *T) error) error {
+	if q.Len() == 0 {
+		return ErrQueueEmpty
+	}
+	slice := q.RawSlice()
+	if err := fn(&slice[q.ridx]); err != nil {
+		return err
+	}
+	q.ridx += 1
+	q.ridx %= uint32(q.Cap())
+	q.data = q.data[:q.Len()-1]
+	q.gen++
+	return nil
+}
+
+// Hand the caller the (up to 2) contiguous data regions at the front of
+// the queue directly via fn, in the same order as `GetDataSlices()` but
+// truncated to a combined length of max, without removing them first.
+//
+// fn returns the number of values it actually consumed, which must be
+// between 0 and the length of what it was given; that many values are
+// then removed from the front of the queue by advancing the read index.
+// If fn returns 0, the read index is left unchanged. Returns the number
+// of values actually consumed
+func (q *RingQueue[T]) DequeueManyFunc(max int, fn func(slice1, slice2 []T) (nConsumed int)) (nConsumed int) {
+	limited := limitSlicePair(q.GetDataSlices(), max)
+	nConsumed = fn(limited[0], limited[1])
+	if nConsumed > 0 {
+		q.ridx += uint32(nConsumed)
+		q.ridx %= uint32(q.Cap())
+		q.data = q.data[:q.Len()-nConsumed]
+		q.gen++
+	}
+	return
+}
+
+// Truncate a [2][]T pair (as returned by `GetFreeSlices()`/`GetDataSlices()`)
+// to a combined length of n
+func limitSlicePair[T any](s [2][]T, n int) [2][]T {
+	if len(s[0]) >= n {
+		return [2][]T{s[0][:n], s[1][:0]}
+	}
+	rem := n - len(s[0])
+	if rem > len(s[1]) {
+		rem = len(s[1])
+	}
+	return [2][]T{s[0], s[1][:rem]}
+}
@@ -0,0 +1,111 @@
+package go_ring_queue
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestQueueFunc(t *testing.T) {
+	q := New[int](0)
+	if err := q.QueueFunc(func(v *int) error { *v = 42; return nil }); err != nil {
+		t.Fatalf("QueueFunc: %v", err)
+	}
+	if val, ok := q.Dequeue(); !ok || val != 42 {
+		t.Fatalf("expected 42, got %v (ok=%v)", val, ok)
+	}
+
+	errBoom := errors.New("boom")
+	before := q.Len()
+	if err := q.QueueFunc(func(v *int) error { return errBoom }); !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+	if q.Len() != before {
+		t.Fatalf("expected queue length unchanged after fn error, got %d want %d", q.Len(), before)
+	}
+}
+
+func TestQueueManyFunc(t *testing.T) {
+	q := New[int](0)
+	err := q.QueueManyFunc(5, func(s1, s2 []int) (int, error) {
+		vals := []int{1, 2, 3, 4, 5}
+		n := copy(s1, vals)
+		n += copy(s2, vals[n:])
+		return n, nil
+	})
+	if err != nil {
+		t.Fatalf("QueueManyFunc: %v", err)
+	}
+	datas := q.GetDataSlices()
+	flat := append(append([]int{}, datas[0]...), datas[1]...)
+	if !slices.Equal(flat, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected [1 2 3 4 5], got %v", flat)
+	}
+
+	before := q.Len()
+	if err := q.QueueManyFunc(3, func(s1, s2 []int) (int, error) { return 0, nil }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Len() != before {
+		t.Fatalf("expected queue length unchanged after 0 written, got %d want %d", q.Len(), before)
+	}
+}
+
+func TestDequeueFunc(t *testing.T) {
+	q := New[int](0)
+	if err := q.DequeueFunc(func(v *int) error { return nil }); !errors.Is(err, ErrQueueEmpty) {
+		t.Fatalf("expected ErrQueueEmpty, got %v", err)
+	}
+
+	q.QueueMany(10, 20)
+	var got int
+	if err := q.DequeueFunc(func(v *int) error { got = *v; return nil }); err != nil {
+		t.Fatalf("DequeueFunc: %v", err)
+	}
+	if got != 10 {
+		t.Fatalf("expected 10, got %d", got)
+	}
+	if q.Len() != 1 {
+		t.Fatalf("expected length 1 after dequeue, got %d", q.Len())
+	}
+
+	errBoom := errors.New("boom")
+	if err := q.DequeueFunc(func(v *int) error { return errBoom }); !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+	if q.Len() != 1 {
+		t.Fatalf("expected length unchanged after fn error, got %d", q.Len())
+	}
+}
+
+func TestDequeueManyFunc(t *testing.T) {
+	q := New[int](0)
+	q.QueueMany(1, 2, 3, 4, 5)
+	// force a wrap; the first 3 values all sit before the wrap point, so
+	// the callback gets them all in s1 and an empty s2
+	q.Dequeue()
+	q.Dequeue()
+	q.Queue(6)
+	q.Queue(7)
+
+	var got []int
+	n := q.DequeueManyFunc(3, func(s1, s2 []int) int {
+		got = append(append([]int{}, s1...), s2...)
+		return len(got)
+	})
+	if n != 3 {
+		t.Fatalf("expected 3 consumed, got %d", n)
+	}
+	if !slices.Equal(got, []int{3, 4, 5}) {
+		t.Fatalf("expected [3 4 5], got %v", got)
+	}
+	if q.Len() != 2 {
+		t.Fatalf("expected length 2 remaining, got %d", q.Len())
+	}
+
+	before := q.Len()
+	n = q.DequeueManyFunc(5, func(s1, s2 []int) int { return 0 })
+	if n != 0 || q.Len() != before {
+		t.Fatalf("expected no-op on 0 consumed, got n=%d len=%d", n, q.Len())
+	}
+}